@@ -7,29 +7,70 @@ import (
 	"io"
 	"os"
 	"strings"
-	"syscall"
-
-	"golang.org/x/term"
+	"sync"
 )
 
 // ErrInvalidConfirmation is returned when a confirmation input is neither yes/y nor no/n
 var ErrInvalidConfirmation = errors.New("invalid confirmation input")
 
+// PasswordReader reads a password from the given file descriptor without
+// echoing it to the terminal. It is the extension point GetPassword uses,
+// so that password entry can be tested or adapted to platforms/terminals
+// the default x/term-backed implementation doesn't handle.
+type PasswordReader interface {
+	ReadPassword(fd uintptr) ([]byte, error)
+}
+
+// fdReader is implemented by io.Readers that expose an underlying file
+// descriptor, such as *os.File.
+type fdReader interface {
+	Fd() uintptr
+}
+
 // Console provides methods for interacting with the terminal.
 // It uses an io.Reader for input and an io.Writer for output.
 type Console struct {
-	in  io.Reader
-	out io.Writer
+	in                   io.Reader
+	out                  io.Writer
+	reader               *bufio.Reader
+	passwordReader       PasswordReader
+	usingDefaultPwReader bool
+	writer               *wrapWriter
+	lineEditor           LineEditor
+	historyFile          string
+	historyMax           int
+	completer            Completer
+	fallbackLogOnce      *sync.Once
 }
 
 // New creates a new Console instance with standard input and output.
 func New() *Console {
-	return &Console{os.Stdin, os.Stdout}
+	return NewWithStreams(os.Stdin, os.Stdout)
 }
 
 // NewWithStreams creates a new Console instance with the provided input and output streams.
+// The input stream is wrapped in a single persistent *bufio.Reader so that bytes
+// pre-read while buffering are not discarded between calls, allowing multiple
+// prompts to be satisfied from one piped input. in may be any io.Reader; when it
+// also exposes Fd() uintptr (as *os.File does), GetPassword can read from it directly.
 func NewWithStreams(in io.Reader, out io.Writer) *Console {
-	return &Console{in, out}
+	return &Console{
+		in:                   in,
+		out:                  out,
+		reader:               bufio.NewReader(in),
+		passwordReader:       newDefaultPasswordReader(),
+		usingDefaultPwReader: true,
+		writer:               &wrapWriter{out: out},
+		fallbackLogOnce:      &sync.Once{},
+	}
+}
+
+// WithPasswordReader overrides the PasswordReader used by GetPassword, e.g. to
+// inject a fake reader in tests. It returns c for chaining.
+func (c *Console) WithPasswordReader(r PasswordReader) *Console {
+	c.passwordReader = r
+	c.usingDefaultPwReader = false
+	return c
 }
 
 // GetInput writes a prompt to the output and reads a line from the input.
@@ -40,8 +81,7 @@ func (c *Console) GetInput(prompt string) (string, error) {
 		return "", fmt.Errorf("writing prompt: %w", err)
 	}
 
-	reader := bufio.NewReader(c.in)
-	input, err := reader.ReadString('\n')
+	input, err := c.reader.ReadString('\n')
 	if err != nil {
 		return "", fmt.Errorf("reading input: %w", err)
 	}
@@ -79,18 +119,26 @@ func (c *Console) GetConfirm(prompt string, defaultYes bool) (bool, error) {
 }
 
 // GetPassword prompts for a password without echoing the input to the terminal.
-// Note: This only works when c.in is os.Stdin, as it uses terminal-specific functionality.
+// It reads via c.passwordReader, which defaults to an x/term-backed reader fed
+// by c.in's file descriptor. Use WithPasswordReader to supply a fake reader in
+// tests, or to run on an io.Reader that doesn't expose Fd().
 func (c *Console) GetPassword(prompt string) (string, error) {
 	_, err := fmt.Fprint(c.out, prompt)
 	if err != nil {
 		return "", fmt.Errorf("writing prompt: %w", err)
 	}
 
-	if c.in != os.Stdin {
-		return "", fmt.Errorf("password input requires os.Stdin, got different io.Reader")
+	fdr, hasFd := c.in.(fdReader)
+	if c.usingDefaultPwReader && !hasFd {
+		return "", fmt.Errorf("password input requires an io.Reader exposing Fd(), got %T", c.in)
+	}
+
+	var fd uintptr
+	if hasFd {
+		fd = fdr.Fd()
 	}
 
-	password, err := term.ReadPassword(syscall.Stdin)
+	password, err := c.passwordReader.ReadPassword(fd)
 	if err != nil {
 		return "", fmt.Errorf("reading password: %w", err)
 	}
@@ -121,3 +169,8 @@ func GetConfirm(prompt string, defaultYes bool) (bool, error) {
 func GetPassword(prompt string) (string, error) {
 	return defaultConsole.GetPassword(prompt)
 }
+
+// ReadLine reads one line of input through the default console's LineEditor.
+func ReadLine(prompt string) (string, error) {
+	return defaultConsole.ReadLine(prompt)
+}
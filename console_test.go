@@ -1,6 +1,7 @@
 package microconsole
 
 import (
+	"bufio"
 	"bytes"
 	"errors"
 	"fmt"
@@ -291,6 +292,37 @@ func TestConsole_GetPassword(t *testing.T) {
 	})
 }
 
+type fakePasswordReader struct {
+	r io.Reader
+}
+
+func (f fakePasswordReader) ReadPassword(_ uintptr) ([]byte, error) {
+	reader := bufio.NewReader(f.r)
+	line, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return []byte(strings.TrimRight(line, "\r\n")), nil
+}
+
+func TestConsole_GetPassword_WithInjectedReader(t *testing.T) {
+	in := strings.NewReader("s3cr3t\n")
+	out := &bytes.Buffer{}
+
+	console := NewWithStreams(in, out).WithPasswordReader(fakePasswordReader{r: in})
+
+	result, err := console.GetPassword("Password: ")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "s3cr3t" {
+		t.Errorf("Expected 's3cr3t', got '%s'", result)
+	}
+	if out.String() != "Password: \n" {
+		t.Errorf("Expected prompt followed by newline, got '%s'", out.String())
+	}
+}
+
 func TestGetInput(t *testing.T) {
 	originalDefault := defaultConsole
 	defer func() { defaultConsole = originalDefault }()
@@ -409,6 +441,22 @@ func TestConsole_PromptEdgeCases(t *testing.T) {
 	}
 }
 
+func TestConsole_GetInput_MultiplePromptsShareBufferedReader(t *testing.T) {
+	in := strings.NewReader("first\nsecond\nthird\n")
+	out := &bytes.Buffer{}
+	console := NewWithStreams(in, out)
+
+	for _, expected := range []string{"first", "second", "third"} {
+		result, err := console.GetInput("> ")
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result != expected {
+			t.Errorf("Expected '%s', got '%s'", expected, result)
+		}
+	}
+}
+
 func TestConsole_GetInputWithFailingReader(t *testing.T) {
 	failingReader := &failingReader{}
 	console := NewWithStreams(failingReader, &bytes.Buffer{})
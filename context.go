@@ -0,0 +1,95 @@
+package microconsole
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// WithTimeout is sugar for context.WithTimeout(context.Background(), d), for
+// callers that just want to bound a single prompt.
+func WithTimeout(d time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), d)
+}
+
+// unblockReader makes a best-effort attempt to unblock a read that's pending
+// on c.in after a context cancellation, by closing it if it implements
+// io.Closer. There's no portable way to interrupt a blocked Read otherwise.
+func (c *Console) unblockReader() {
+	if closer, ok := c.in.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
+// GetInputContext is GetInput, abandoned if ctx is done first. On cancellation
+// it returns ctx.Err() wrapped and makes a best-effort attempt to unblock the
+// reader; the abandoned read may still complete in the background.
+func (c *Console) GetInputContext(ctx context.Context, prompt string) (string, error) {
+	type result struct {
+		value string
+		err   error
+	}
+	ch := make(chan result, 1)
+
+	go func() {
+		value, err := c.GetInput(prompt)
+		ch <- result{value, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		c.unblockReader()
+		return "", fmt.Errorf("get input: %w", ctx.Err())
+	case res := <-ch:
+		return res.value, res.err
+	}
+}
+
+// GetConfirmContext is GetConfirm, abandoned if ctx is done first.
+func (c *Console) GetConfirmContext(ctx context.Context, prompt string, defaultYes bool) (bool, error) {
+	type result struct {
+		value bool
+		err   error
+	}
+	ch := make(chan result, 1)
+
+	go func() {
+		value, err := c.GetConfirm(prompt, defaultYes)
+		ch <- result{value, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		c.unblockReader()
+		return false, fmt.Errorf("get confirm: %w", ctx.Err())
+	case res := <-ch:
+		return res.value, res.err
+	}
+}
+
+// GetPasswordContext is GetPassword, abandoned if ctx is done first. Since a
+// pending password read blocks on the raw file descriptor rather than on
+// c.in's Read method, unblocking it is best-effort: we close c.in if possible
+// and write a newline so the terminal isn't left mid-prompt.
+func (c *Console) GetPasswordContext(ctx context.Context, prompt string) (string, error) {
+	type result struct {
+		value string
+		err   error
+	}
+	ch := make(chan result, 1)
+
+	go func() {
+		value, err := c.GetPassword(prompt)
+		ch <- result{value, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		c.unblockReader()
+		fmt.Fprintln(c.out)
+		return "", fmt.Errorf("get password: %w", ctx.Err())
+	case res := <-ch:
+		return res.value, res.err
+	}
+}
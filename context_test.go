@@ -0,0 +1,104 @@
+package microconsole
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// blockingReader never returns from Read until closed, simulating a prompt
+// with no data available yet.
+type blockingReader struct {
+	closed chan struct{}
+}
+
+func newBlockingReader() *blockingReader {
+	return &blockingReader{closed: make(chan struct{})}
+}
+
+func (b *blockingReader) Read(_ []byte) (int, error) {
+	<-b.closed
+	return 0, io.EOF
+}
+
+func (b *blockingReader) Close() error {
+	select {
+	case <-b.closed:
+	default:
+		close(b.closed)
+	}
+	return nil
+}
+
+func TestConsole_GetInputContext_CancelReturnsPromptly(t *testing.T) {
+	in := newBlockingReader()
+	defer in.Close()
+	out := &bytes.Buffer{}
+	console := NewWithStreams(in, out)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	var err error
+	go func() {
+		_, err = console.GetInputContext(ctx, "> ")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("GetInputContext did not return promptly after cancellation")
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+}
+
+func TestConsole_GetConfirmContext_TimeoutReturnsPromptly(t *testing.T) {
+	in := newBlockingReader()
+	defer in.Close()
+	out := &bytes.Buffer{}
+	console := NewWithStreams(in, out)
+
+	ctx, cancel := WithTimeout(10 * time.Millisecond)
+	defer cancel()
+
+	done := make(chan struct{})
+	var err error
+	go func() {
+		_, err = console.GetConfirmContext(ctx, "Confirm?", false)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("GetConfirmContext did not return promptly after timeout")
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got: %v", err)
+	}
+}
+
+func TestConsole_GetInputContext_CompletesNormallyWithoutCancellation(t *testing.T) {
+	in := &bytes.Buffer{}
+	in.WriteString("hello\n")
+	out := &bytes.Buffer{}
+	console := NewWithStreams(in, out)
+
+	ctx := context.Background()
+	result, err := console.GetInputContext(ctx, "> ")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "hello" {
+		t.Errorf("Expected 'hello', got '%s'", result)
+	}
+}
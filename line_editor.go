@@ -0,0 +1,261 @@
+package microconsole
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// Completer suggests completions for line at cursor position pos. head is the
+// portion of line before the completions, tail the portion after.
+type Completer func(line string, pos int) (head string, completions []string, tail string)
+
+// LineEditor reads a line of input, optionally offering history recall and
+// editing beyond what GetInput provides.
+type LineEditor interface {
+	Readline(prompt string) (string, error)
+	AppendHistory(string)
+}
+
+// dumbLineEditor does what GetInput does today. It's used automatically when
+// c.in isn't a terminal, so callers and tests keep working unchanged with a
+// strings.Reader.
+type dumbLineEditor struct {
+	console *Console
+}
+
+func (d *dumbLineEditor) Readline(prompt string) (string, error) {
+	return d.console.GetInput(prompt)
+}
+
+func (d *dumbLineEditor) AppendHistory(string) {}
+
+// richLineEditor provides arrow-key history recall, Ctrl-A/E/U/K editing, and
+// optional tab completion over a real terminal.
+type richLineEditor struct {
+	console     *Console
+	history     []string
+	historyFile string
+	historyMax  int
+	completer   Completer
+}
+
+// AppendHistory records line, trims to historyMax when set, and persists to
+// historyFile when one was configured via WithHistoryFile.
+func (r *richLineEditor) AppendHistory(line string) {
+	if line == "" {
+		return
+	}
+
+	r.history = append(r.history, line)
+	if r.historyMax > 0 && len(r.history) > r.historyMax {
+		r.history = r.history[len(r.history)-r.historyMax:]
+	}
+	r.saveHistory()
+}
+
+func (r *richLineEditor) loadHistory() {
+	if r.historyFile == "" {
+		return
+	}
+
+	data, err := os.ReadFile(r.historyFile)
+	if err != nil {
+		return
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		if line != "" {
+			r.history = append(r.history, line)
+		}
+	}
+}
+
+func (r *richLineEditor) saveHistory() {
+	if r.historyFile == "" {
+		return
+	}
+	_ = os.WriteFile(r.historyFile, []byte(strings.Join(r.history, "\n")+"\n"), 0o600)
+}
+
+// Readline puts the terminal in raw mode and runs the byte-level editing loop
+// from readEditedLine, falling back to a plain GetInput read when c.in isn't
+// a real terminal.
+func (r *richLineEditor) Readline(prompt string) (string, error) {
+	fdr, hasFd := r.console.in.(fdReader)
+	if !hasFd || !term.IsTerminal(int(fdr.Fd())) {
+		return r.console.GetInput(prompt)
+	}
+
+	fd := int(fdr.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return "", fmt.Errorf("entering raw mode: %w", err)
+	}
+	defer term.Restore(fd, oldState)
+
+	return readEditedLine(r.console.in, r.console.out, prompt, r)
+}
+
+// readEditedLine implements the key handling for richLineEditor. It's kept
+// separate from Readline so it can be driven with a strings.Reader in tests,
+// without needing a real terminal in raw mode.
+func readEditedLine(in io.Reader, out io.Writer, prompt string, r *richLineEditor) (string, error) {
+	var buf []rune
+	cursor := 0
+	histIdx := len(r.history)
+	b := make([]byte, 3)
+
+	fmt.Fprint(out, prompt)
+
+	redraw := func() {
+		fmt.Fprint(out, "\r\x1b[K", prompt, string(buf))
+		if back := len(buf) - cursor; back > 0 {
+			fmt.Fprintf(out, "\x1b[%dD", back)
+		}
+	}
+
+	for {
+		n, err := in.Read(b[:1])
+		if n == 0 {
+			if errors.Is(err, io.EOF) {
+				return string(buf), io.EOF
+			}
+			return "", fmt.Errorf("reading input: %w", err)
+		}
+
+		switch b[0] {
+		case '\r', '\n':
+			fmt.Fprintln(out)
+			line := string(buf)
+			r.AppendHistory(line)
+			return line, nil
+		case 1: // Ctrl-A: start of line
+			cursor = 0
+			redraw()
+		case 5: // Ctrl-E: end of line
+			cursor = len(buf)
+			redraw()
+		case 21: // Ctrl-U: clear to start of line
+			buf = buf[cursor:]
+			cursor = 0
+			redraw()
+		case 11: // Ctrl-K: clear to end of line
+			buf = buf[:cursor]
+			redraw()
+		case '\t':
+			if r.completer != nil {
+				head, completions, tail := r.completer(string(buf), cursor)
+				if len(completions) == 1 {
+					buf = []rune(head + completions[0] + tail)
+					cursor = len([]rune(head + completions[0]))
+				} else if len(completions) > 1 {
+					fmt.Fprintln(out)
+					fmt.Fprintln(out, strings.Join(completions, "  "))
+				}
+			}
+			redraw()
+		case '\b', 0x7f:
+			if cursor > 0 {
+				buf = append(buf[:cursor-1], buf[cursor:]...)
+				cursor--
+			}
+			redraw()
+		case 0x1b:
+			_, _ = in.Read(b[1:3])
+			if b[1] == '[' {
+				switch b[2] {
+				case 'A':
+					if histIdx > 0 {
+						histIdx--
+						buf = []rune(r.history[histIdx])
+						cursor = len(buf)
+					}
+				case 'B':
+					if histIdx < len(r.history)-1 {
+						histIdx++
+						buf = []rune(r.history[histIdx])
+					} else {
+						histIdx = len(r.history)
+						buf = nil
+					}
+					cursor = len(buf)
+				case 'C':
+					if cursor < len(buf) {
+						cursor++
+					}
+				case 'D':
+					if cursor > 0 {
+						cursor--
+					}
+				}
+			}
+			redraw()
+		default:
+			if b[0] >= 0x20 && b[0] < 0x7f {
+				buf = append(buf[:cursor], append([]rune{rune(b[0])}, buf[cursor:]...)...)
+				cursor++
+				redraw()
+			}
+		}
+	}
+}
+
+// ReadLine reads one line of input through the Console's LineEditor: the rich
+// editor when c.in is a real terminal, the dumb one otherwise. Set an editor
+// explicitly with WithLineEditor.
+func (c *Console) ReadLine(prompt string) (string, error) {
+	return c.resolvedLineEditor().Readline(prompt)
+}
+
+func (c *Console) resolvedLineEditor() LineEditor {
+	if c.lineEditor != nil {
+		return c.lineEditor
+	}
+
+	fdr, hasFd := c.in.(fdReader)
+	if !hasFd || !term.IsTerminal(int(fdr.Fd())) {
+		c.fallbackLogOnce.Do(func() {
+			log.Println("microconsole: no usable terminal, falling back to basic line input")
+		})
+		c.lineEditor = &dumbLineEditor{console: c}
+		return c.lineEditor
+	}
+
+	rich := &richLineEditor{
+		console:     c,
+		historyFile: c.historyFile,
+		historyMax:  c.historyMax,
+		completer:   c.completer,
+	}
+	rich.loadHistory()
+	c.lineEditor = rich
+	return rich
+}
+
+// WithLineEditor overrides the LineEditor used by ReadLine, e.g. to inject a
+// fake editor in tests. It returns c for chaining.
+func (c *Console) WithLineEditor(e LineEditor) *Console {
+	c.lineEditor = e
+	return c
+}
+
+// WithHistoryFile persists the rich line editor's history to path, keeping at
+// most max entries (0 for unlimited). It has no effect on the dumb editor.
+func (c *Console) WithHistoryFile(path string, max int) *Console {
+	c.historyFile = path
+	c.historyMax = max
+	return c
+}
+
+// WithCompleter registers fn as the tab-completion callback for the rich line
+// editor. It has no effect on the dumb editor.
+func (c *Console) WithCompleter(fn Completer) *Console {
+	c.completer = fn
+	return c
+}
@@ -0,0 +1,179 @@
+package microconsole
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConsole_ReadLine_FallsBackToDumbEditorWhenNotATerminal(t *testing.T) {
+	in := strings.NewReader("hello\n")
+	out := &bytes.Buffer{}
+	console := NewWithStreams(in, out)
+
+	result, err := console.ReadLine("> ")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "hello" {
+		t.Errorf("Expected 'hello', got '%s'", result)
+	}
+}
+
+type fakeLineEditor struct {
+	lastPrompt string
+	toReturn   string
+	history    []string
+}
+
+func (f *fakeLineEditor) Readline(prompt string) (string, error) {
+	f.lastPrompt = prompt
+	return f.toReturn, nil
+}
+
+func (f *fakeLineEditor) AppendHistory(line string) {
+	f.history = append(f.history, line)
+}
+
+func TestConsole_ReadLine_UsesInjectedEditor(t *testing.T) {
+	console := NewWithStreams(strings.NewReader(""), &bytes.Buffer{})
+	fake := &fakeLineEditor{toReturn: "injected"}
+	console.WithLineEditor(fake)
+
+	result, err := console.ReadLine("prompt> ")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "injected" {
+		t.Errorf("Expected 'injected', got '%s'", result)
+	}
+	if fake.lastPrompt != "prompt> " {
+		t.Errorf("Expected editor to receive 'prompt> ', got '%s'", fake.lastPrompt)
+	}
+}
+
+func TestReadEditedLine_PlainTyping(t *testing.T) {
+	in := strings.NewReader("hello\r")
+	out := &bytes.Buffer{}
+	r := &richLineEditor{}
+
+	result, err := readEditedLine(in, out, "> ", r)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "hello" {
+		t.Errorf("Expected 'hello', got '%s'", result)
+	}
+	if len(r.history) != 1 || r.history[0] != "hello" {
+		t.Errorf("Expected history to contain 'hello', got %v", r.history)
+	}
+}
+
+func TestReadEditedLine_Backspace(t *testing.T) {
+	in := strings.NewReader("abc\x7f\r")
+	out := &bytes.Buffer{}
+	r := &richLineEditor{}
+
+	result, err := readEditedLine(in, out, "> ", r)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "ab" {
+		t.Errorf("Expected 'ab', got '%s'", result)
+	}
+}
+
+func TestReadEditedLine_CtrlAThenCtrlK(t *testing.T) {
+	// type "hello", Ctrl-A (move to start), Ctrl-K (clear to end)
+	in := strings.NewReader("hello\x01\x0b\r")
+	out := &bytes.Buffer{}
+	r := &richLineEditor{}
+
+	result, err := readEditedLine(in, out, "> ", r)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "" {
+		t.Errorf("Expected '', got '%s'", result)
+	}
+}
+
+func TestReadEditedLine_CtrlAThenInsertThenCtrlU(t *testing.T) {
+	// type "hello", Ctrl-A, type "X", Ctrl-U (clear before cursor)
+	in := strings.NewReader("hello\x01X\x15\r")
+	out := &bytes.Buffer{}
+	r := &richLineEditor{}
+
+	result, err := readEditedLine(in, out, "> ", r)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "hello" {
+		t.Errorf("Expected 'hello', got '%s'", result)
+	}
+}
+
+func TestReadEditedLine_HistoryRecall(t *testing.T) {
+	in := strings.NewReader("\x1b[A\x1b[A\r")
+	out := &bytes.Buffer{}
+	r := &richLineEditor{history: []string{"foo", "bar"}}
+
+	result, err := readEditedLine(in, out, "> ", r)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "foo" {
+		t.Errorf("Expected 'foo' (oldest entry after two ups), got '%s'", result)
+	}
+}
+
+func TestReadEditedLine_TabCompletion(t *testing.T) {
+	in := strings.NewReader("fo\t\r")
+	out := &bytes.Buffer{}
+	r := &richLineEditor{
+		completer: func(line string, pos int) (string, []string, string) {
+			if line == "fo" {
+				return "", []string{"foo"}, ""
+			}
+			return line[:pos], nil, line[pos:]
+		},
+	}
+
+	result, err := readEditedLine(in, out, "> ", r)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "foo" {
+		t.Errorf("Expected 'foo', got '%s'", result)
+	}
+}
+
+func TestRichLineEditor_HistoryFileRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "history")
+
+	r := &richLineEditor{historyFile: path, historyMax: 2}
+	r.AppendHistory("one")
+	r.AppendHistory("two")
+	r.AppendHistory("three")
+
+	if len(r.history) != 2 || r.history[0] != "two" || r.history[1] != "three" {
+		t.Errorf("Expected history trimmed to last 2 entries, got %v", r.history)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected history file to exist, got: %v", err)
+	}
+	if string(data) != "two\nthree\n" {
+		t.Errorf("Expected persisted history 'two\\nthree\\n', got '%s'", string(data))
+	}
+
+	loaded := &richLineEditor{historyFile: path}
+	loaded.loadHistory()
+	if len(loaded.history) != 2 || loaded.history[0] != "two" || loaded.history[1] != "three" {
+		t.Errorf("Expected loaded history to match persisted entries, got %v", loaded.history)
+	}
+}
@@ -0,0 +1,76 @@
+package microconsole
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// GetPasswordMasked prompts for a password, echoing mask to the output for
+// every character typed so the user gets visual feedback, while never writing
+// the actual characters. Backspace (0x08) and DEL (0x7f) erase the last
+// character typed, both from the buffer and from the screen.
+//
+// If c.in is not a real terminal (e.g. in tests, or piped input), it falls
+// back to an unmasked line read from the persistent buffered reader.
+func (c *Console) GetPasswordMasked(prompt string, mask rune) (string, error) {
+	_, err := fmt.Fprint(c.out, prompt)
+	if err != nil {
+		return "", fmt.Errorf("writing prompt: %w", err)
+	}
+
+	fdr, hasFd := c.in.(fdReader)
+	if !hasFd || !term.IsTerminal(int(fdr.Fd())) {
+		input, err := c.reader.ReadString('\n')
+		if err != nil {
+			return "", fmt.Errorf("reading input: %w", err)
+		}
+		return strings.TrimSpace(input), nil
+	}
+
+	fd := int(fdr.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return "", fmt.Errorf("entering raw mode: %w", err)
+	}
+	defer term.Restore(fd, oldState)
+
+	return readMaskedLine(c.in, c.out, mask)
+}
+
+// readMaskedLine reads a password one byte at a time from in, writing mask to
+// out for each printable byte and erasing it with "\b \b" on backspace/DEL,
+// until a CR or LF ends the password.
+func readMaskedLine(in io.Reader, out io.Writer, mask rune) (string, error) {
+	var buf []rune
+	b := make([]byte, 1)
+
+	for {
+		n, err := in.Read(b)
+		if n == 0 {
+			if errors.Is(err, io.EOF) {
+				return string(buf), nil
+			}
+			return "", fmt.Errorf("reading password: %w", err)
+		}
+
+		switch b[0] {
+		case '\r', '\n':
+			fmt.Fprintln(out)
+			return string(buf), nil
+		case '\b', 0x7f:
+			if len(buf) > 0 {
+				buf = buf[:len(buf)-1]
+				fmt.Fprint(out, "\b \b")
+			}
+		default:
+			if b[0] >= 0x20 && b[0] < 0x7f {
+				buf = append(buf, rune(b[0]))
+				fmt.Fprintf(out, "%c", mask)
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package microconsole
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadMaskedLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "CR ends input",
+			input:    "hunter2\r",
+			expected: "hunter2",
+		},
+		{
+			name:     "LF ends input",
+			input:    "hunter2\n",
+			expected: "hunter2",
+		},
+		{
+			name:     "CRLF ends input",
+			input:    "hunter2\r\n",
+			expected: "hunter2",
+		},
+		{
+			name:     "backspace deletes last rune",
+			input:    "abc\bd\r",
+			expected: "abd",
+		},
+		{
+			name:     "DEL deletes last rune",
+			input:    "abc\x7fd\r",
+			expected: "abd",
+		},
+		{
+			name:     "mixed backspace and DEL",
+			input:    "ab\b\x7fc\r",
+			expected: "c",
+		},
+		{
+			name:     "deletes before any input are no-ops",
+			input:    "\b\x7f\x7fa\r",
+			expected: "a",
+		},
+		{
+			name:     "EOF with no terminator returns what was typed",
+			input:    "abc",
+			expected: "abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := strings.NewReader(tt.input)
+			out := &bytes.Buffer{}
+
+			result, err := readMaskedLine(in, out, '*')
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestConsole_GetPasswordMasked_FallsBackWhenNotATerminal(t *testing.T) {
+	in := strings.NewReader("hunter2\n")
+	out := &bytes.Buffer{}
+
+	console := NewWithStreams(in, out)
+	result, err := console.GetPasswordMasked("Password: ", '*')
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result != "hunter2" {
+		t.Errorf("Expected 'hunter2', got '%s'", result)
+	}
+	if out.String() != "Password: " {
+		t.Errorf("Expected prompt only (no mask echoed on fallback), got '%s'", out.String())
+	}
+}
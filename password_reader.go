@@ -0,0 +1,17 @@
+//go:build !windows
+
+package microconsole
+
+import "golang.org/x/term"
+
+// termPasswordReader is the default PasswordReader, backed directly by x/term.
+type termPasswordReader struct{}
+
+func (termPasswordReader) ReadPassword(fd uintptr) ([]byte, error) {
+	return term.ReadPassword(int(fd))
+}
+
+// newDefaultPasswordReader returns the platform default PasswordReader.
+func newDefaultPasswordReader() PasswordReader {
+	return termPasswordReader{}
+}
@@ -0,0 +1,34 @@
+//go:build windows
+
+package microconsole
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// windowsPasswordReader reads a password from the console. When fd does not
+// refer to a real Windows console (e.g. under ConPTY-less terminals like
+// mintty or ConEmu), it falls back to opening CONIN$ directly.
+type windowsPasswordReader struct{}
+
+func (windowsPasswordReader) ReadPassword(fd uintptr) ([]byte, error) {
+	if term.IsTerminal(int(fd)) {
+		return term.ReadPassword(int(fd))
+	}
+
+	conin, err := os.Open("CONIN$")
+	if err != nil {
+		return nil, fmt.Errorf("opening CONIN$: %w", err)
+	}
+	defer conin.Close()
+
+	return term.ReadPassword(int(conin.Fd()))
+}
+
+// newDefaultPasswordReader returns the platform default PasswordReader.
+func newDefaultPasswordReader() PasswordReader {
+	return windowsPasswordReader{}
+}
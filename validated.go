@@ -0,0 +1,127 @@
+package microconsole
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// inputOptions holds the configuration built up by InputOption values.
+type inputOptions struct {
+	maxAttempts  int
+	defaultValue string
+	hasDefault   bool
+}
+
+// InputOption configures GetInputValidated.
+type InputOption func(*inputOptions)
+
+// WithMaxAttempts sets how many times in total GetInputValidated prompts,
+// the first prompt included, before giving up. The default is 3.
+func WithMaxAttempts(n int) InputOption {
+	return func(o *inputOptions) {
+		o.maxAttempts = n
+	}
+}
+
+// WithDefault makes an empty line yield value, mirroring GetConfirm's
+// defaultYes behavior.
+func WithDefault(value string) InputOption {
+	return func(o *inputOptions) {
+		o.defaultValue = value
+		o.hasDefault = true
+	}
+}
+
+// GetInputValidated prompts for input and re-prompts while validate returns
+// an error, for at most WithMaxAttempts prompts in total (default 3). The
+// error message is printed to the output between attempts. If every attempt
+// fails validation, the returned error wraps the last validation error.
+func (c *Console) GetInputValidated(prompt string, validate func(string) error, opts ...InputOption) (string, error) {
+	options := inputOptions{maxAttempts: 3}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < options.maxAttempts; attempt++ {
+		input, err := c.GetInput(prompt)
+		if err != nil {
+			return "", err
+		}
+
+		if input == "" && options.hasDefault {
+			input = options.defaultValue
+		}
+
+		if err := validate(input); err != nil {
+			lastErr = err
+			fmt.Fprintln(c.out, err)
+			continue
+		}
+
+		return input, nil
+	}
+
+	return "", fmt.Errorf("exceeded maximum attempts (%d): %w", options.maxAttempts, lastErr)
+}
+
+// GetInt prompts for an integer between min and max (inclusive), re-prompting
+// on non-numeric or out-of-range input.
+func (c *Console) GetInt(prompt string, min, max int) (int, error) {
+	var result int
+
+	validate := func(s string) error {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("%q is not a valid integer", s)
+		}
+		if n < min || n > max {
+			return fmt.Errorf("%d is not between %d and %d", n, min, max)
+		}
+		result = n
+		return nil
+	}
+
+	if _, err := c.GetInputValidated(prompt, validate); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+// GetChoice prompts for one of choices, matched case-insensitively, and
+// appends a "[a/b/c]" suffix to the prompt like GetConfirm does. The returned
+// value is the matching entry from choices, in its original casing.
+func (c *Console) GetChoice(prompt string, choices []string) (string, error) {
+	suffix := fmt.Sprintf(" [%s]: ", strings.Join(choices, "/"))
+
+	var result string
+	validate := func(s string) error {
+		for _, choice := range choices {
+			if strings.EqualFold(s, choice) {
+				result = choice
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %s", s, strings.Join(choices, ", "))
+	}
+
+	if _, err := c.GetInputValidated(prompt+suffix, validate); err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+// GetMatching prompts for input matching the regular expression re,
+// re-prompting on a mismatch for at most 3 prompts in total.
+func (c *Console) GetMatching(prompt string, re *regexp.Regexp) (string, error) {
+	validate := func(s string) error {
+		if !re.MatchString(s) {
+			return fmt.Errorf("%q does not match pattern %s", s, re.String())
+		}
+		return nil
+	}
+
+	return c.GetInputValidated(prompt, validate)
+}
@@ -0,0 +1,181 @@
+package microconsole
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestConsole_GetInputValidated(t *testing.T) {
+	alwaysValid := func(string) error { return nil }
+	mustBeFoo := func(s string) error {
+		if s != "foo" {
+			return errors.New("must be foo")
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		validate      func(string) error
+		opts          []InputOption
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:     "Valid on first attempt",
+			input:    "foo\n",
+			validate: mustBeFoo,
+			expected: "foo",
+		},
+		{
+			name:     "Valid after retries",
+			input:    "bar\nbaz\nfoo\n",
+			validate: mustBeFoo,
+			expected: "foo",
+		},
+		{
+			name:          "Exhausts attempts",
+			input:         "bar\nbaz\nqux\n",
+			validate:      mustBeFoo,
+			expectedError: true,
+		},
+		{
+			name:     "WithMaxAttempts extends retries",
+			input:    "a\nb\nc\nfoo\n",
+			validate: mustBeFoo,
+			opts:     []InputOption{WithMaxAttempts(4)},
+			expected: "foo",
+		},
+		{
+			name:     "WithDefault fills empty input",
+			input:    "\n",
+			validate: alwaysValid,
+			opts:     []InputOption{WithDefault("fallback")},
+			expected: "fallback",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := strings.NewReader(tt.input)
+			out := &bytes.Buffer{}
+			console := NewWithStreams(in, out)
+
+			result, err := console.GetInputValidated("Enter: ", tt.validate, tt.opts...)
+
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if !tt.expectedError && result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestConsole_GetInt(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		min, max      int
+		expected      int
+		expectedError bool
+	}{
+		{name: "Valid in range", input: "5\n", min: 1, max: 10, expected: 5},
+		{name: "Not a number then valid", input: "abc\n7\n", min: 1, max: 10, expected: 7},
+		{name: "Out of range then valid", input: "99\n3\n", min: 1, max: 10, expected: 3},
+		{name: "Exhausts attempts", input: "a\nb\nc\n", min: 1, max: 10, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := strings.NewReader(tt.input)
+			out := &bytes.Buffer{}
+			console := NewWithStreams(in, out)
+
+			result, err := console.GetInt("Enter a number: ", tt.min, tt.max)
+
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if !tt.expectedError && result != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestConsole_GetChoice(t *testing.T) {
+	choices := []string{"red", "green", "blue"}
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError bool
+	}{
+		{name: "Exact match", input: "green\n", expected: "green"},
+		{name: "Case-insensitive match", input: "RED\n", expected: "red"},
+		{name: "Invalid then valid", input: "yellow\nblue\n", expected: "blue"},
+		{name: "Exhausts attempts", input: "a\nb\nc\n", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := strings.NewReader(tt.input)
+			out := &bytes.Buffer{}
+			console := NewWithStreams(in, out)
+
+			result, err := console.GetChoice("Pick a color", choices)
+
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if !tt.expectedError && result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+			if !tt.expectedError {
+				expectedPrompt := "Pick a color [red/green/blue]: "
+				if !strings.HasPrefix(out.String(), expectedPrompt) {
+					t.Errorf("Expected prompt to start with '%s', got '%s'", expectedPrompt, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestConsole_GetMatching(t *testing.T) {
+	re := regexp.MustCompile(`^[a-z]+@[a-z]+\.[a-z]+$`)
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError bool
+	}{
+		{name: "Valid on first attempt", input: "a@b.com\n", expected: "a@b.com"},
+		{name: "Invalid then valid", input: "not-an-email\na@b.com\n", expected: "a@b.com"},
+		{name: "Exhausts attempts", input: "x\ny\nz\n", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := strings.NewReader(tt.input)
+			out := &bytes.Buffer{}
+			console := NewWithStreams(in, out)
+
+			result, err := console.GetMatching("Enter email: ", re)
+
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if !tt.expectedError && result != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
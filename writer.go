@@ -0,0 +1,119 @@
+package microconsole
+
+import (
+	"fmt"
+	"io"
+
+	"golang.org/x/term"
+)
+
+// wrapWriter wraps an io.Writer and reflows written text to a target column
+// width, tracking the current line length across Write calls so that a
+// prompt built from several Print/Printf calls still wraps correctly.
+type wrapWriter struct {
+	out         io.Writer
+	forcedWidth int
+	lineLen     int
+}
+
+// width returns the column width to wrap at, or 0 to pass bytes through
+// unchanged (forced width takes precedence over detection; detection only
+// kicks in when out is a terminal).
+func (w *wrapWriter) width() int {
+	if w.forcedWidth > 0 {
+		return w.forcedWidth
+	}
+
+	f, ok := w.out.(fdReader)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return 0
+	}
+
+	cols, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0
+	}
+	return cols
+}
+
+func (w *wrapWriter) Write(p []byte) (int, error) {
+	cols := w.width()
+	if cols <= 0 {
+		return w.out.Write(p)
+	}
+
+	var buf []byte
+	text := string(p)
+
+	for i := 0; i < len(text); {
+		if text[i] == '\n' {
+			buf = append(buf, '\n')
+			w.lineLen = 0
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(text) && text[j] != ' ' && text[j] != '\n' {
+			j++
+		}
+		word := text[i:j]
+
+		switch {
+		case w.lineLen > 0 && w.lineLen+1+len(word) > cols:
+			buf = append(buf, '\n')
+			w.lineLen = 0
+		case w.lineLen > 0:
+			buf = append(buf, ' ')
+			w.lineLen++
+		}
+		buf = append(buf, word...)
+		w.lineLen += len(word)
+
+		i = j
+		if i < len(text) && text[i] == ' ' {
+			i++
+		}
+	}
+
+	if _, err := w.out.Write(buf); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Writer returns an io.Writer that reflows written text to the current
+// terminal width of c's output stream, or passes bytes through unchanged when
+// that output is not a terminal (tests, pipes, redirected files). Use WrapAt
+// to force a width instead of detecting one.
+func (c *Console) Writer() io.Writer {
+	return c.writer
+}
+
+// WrapAt forces Console.Writer to wrap at cols columns instead of detecting
+// the terminal width. It returns c for chaining.
+func (c *Console) WrapAt(cols int) *Console {
+	c.writer.forcedWidth = cols
+	return c
+}
+
+// Printf formats according to format and writes to c.Writer().
+func (c *Console) Printf(format string, args ...interface{}) (int, error) {
+	return fmt.Fprintf(c.Writer(), format, args...)
+}
+
+// Println writes args to c.Writer(), space-separated, followed by a newline.
+func (c *Console) Println(args ...interface{}) (int, error) {
+	return fmt.Fprintln(c.Writer(), args...)
+}
+
+// Print writes args to c.Writer().
+func (c *Console) Print(args ...interface{}) (int, error) {
+	return fmt.Fprint(c.Writer(), args...)
+}
+
+// Writer returns an io.Writer that reflows written text to the current
+// terminal width of the default console's output stream.
+func Writer() io.Writer {
+	return defaultConsole.Writer()
+}
@@ -0,0 +1,71 @@
+package microconsole
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrapWriter_PassesThroughWhenNotATerminal(t *testing.T) {
+	out := &bytes.Buffer{}
+	console := NewWithStreams(&bytes.Buffer{}, out)
+
+	_, err := console.Printf("this is a long line that would wrap if %s was a terminal", "out")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "this is a long line that would wrap if out was a terminal"
+	if out.String() != expected {
+		t.Errorf("Expected unwrapped text '%s', got '%s'", expected, out.String())
+	}
+}
+
+func TestWrapWriter_WrapAtForcesWidth(t *testing.T) {
+	out := &bytes.Buffer{}
+	console := NewWithStreams(&bytes.Buffer{}, out).WrapAt(10)
+
+	_, err := console.Print("one two three four five")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "one two\nthree four\nfive"
+	if out.String() != expected {
+		t.Errorf("Expected wrapped text '%s', got '%s'", expected, out.String())
+	}
+}
+
+func TestWrapWriter_PreservesExistingNewlines(t *testing.T) {
+	out := &bytes.Buffer{}
+	console := NewWithStreams(&bytes.Buffer{}, out).WrapAt(80)
+
+	_, err := console.Println("first line")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	_, err = console.Println("second line")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "first line\nsecond line\n"
+	if out.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, out.String())
+	}
+}
+
+func TestWriter_PackageLevel(t *testing.T) {
+	originalDefault := defaultConsole
+	defer func() { defaultConsole = originalDefault }()
+
+	out := &bytes.Buffer{}
+	defaultConsole = NewWithStreams(&bytes.Buffer{}, out)
+
+	_, err := Writer().Write([]byte("hello"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if out.String() != "hello" {
+		t.Errorf("Expected 'hello', got '%s'", out.String())
+	}
+}